@@ -0,0 +1,67 @@
+package snowflake
+
+import (
+	"errors"
+	"net"
+)
+
+var (
+	ErrorDatacenterOverflow = errors.New("datacenter ID overflowed its bit allowance")
+	ErrorNoPrivateIPv4      = errors.New("no private ipv4 address found to derive a worker ID from")
+)
+
+// NewNodeWithDC is like NewNode, but splits the node field into a datacenter component and a
+// worker component. layout's NodeBits is derived from DatacenterBits+WorkerBits and need not
+// be set by the caller.
+func NewNodeWithDC(datacenterID, workerID uint32, layout Layout, opts ...NodeOption) (*Node, error) {
+	layout.NodeBits = layout.DatacenterBits + layout.WorkerBits
+	if int64(datacenterID) > maxValueBits(layout.DatacenterBits) {
+		return nil, ErrorDatacenterOverflow
+	}
+	if int64(workerID) > maxValueBits(layout.WorkerBits) {
+		return nil, ErrorNodeOverflow
+	}
+	id := int64(datacenterID)<<layout.WorkerBits | int64(workerID)
+	return newNode(id, layout, opts...)
+}
+
+// Datacenter returns the datacenter component of the Node's own ID, as split by NewNodeWithDC.
+func (n *Node) Datacenter() int64 {
+	return n.id >> n.layout.WorkerBits
+}
+
+// Worker returns the worker component of the Node's own ID, as split by NewNodeWithDC.
+func (n *Node) Worker() int64 {
+	return n.id & maxValueBits(n.layout.WorkerBits)
+}
+
+// Datacenter extracts the datacenter component of the node ID encoded in s under this Layout.
+func (l Layout) Datacenter(s Snowflake) int64 {
+	return l.Node(s) >> l.WorkerBits
+}
+
+// Worker extracts the worker component of the node ID encoded in s under this Layout.
+func (l Layout) Worker(s Snowflake) int64 {
+	return l.Node(s) & maxValueBits(l.WorkerBits)
+}
+
+// WorkerIDFromIP derives a worker ID from the last two octets of the host's private IPv4
+// address, for callers that want to self-assign a worker ID without an external coordinator.
+func WorkerIDFromIP() (uint32, error) {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return 0, err
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() {
+			continue
+		}
+		ip4 := ipNet.IP.To4()
+		if ip4 == nil || !ip4.IsPrivate() {
+			continue
+		}
+		return uint32(ip4[2])<<8 | uint32(ip4[3]), nil
+	}
+	return 0, ErrorNoPrivateIPv4
+}
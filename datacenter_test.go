@@ -0,0 +1,76 @@
+package snowflake
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestNewNodeWithDCOverflow(t *testing.T) {
+	layout := Layout{Epoch: time.Now(), TimeBits: 41, DatacenterBits: 5, WorkerBits: 5, CountBits: 12}
+
+	if _, err := NewNodeWithDC(1<<5, 0, layout); err != ErrorDatacenterOverflow {
+		t.Errorf("NewNodeWithDC() error = %v, want ErrorDatacenterOverflow", err)
+	}
+	if _, err := NewNodeWithDC(0, 1<<5, layout); err != ErrorNodeOverflow {
+		t.Errorf("NewNodeWithDC() error = %v, want ErrorNodeOverflow", err)
+	}
+}
+
+func TestNewNodeWithDCAccessors(t *testing.T) {
+	layout := Layout{Epoch: time.Now(), TimeBits: 41, DatacenterBits: 5, WorkerBits: 5, CountBits: 12}
+	n, err := NewNodeWithDC(7, 13, layout)
+	if err != nil {
+		t.Fatalf("NewNodeWithDC() error = %v", err)
+	}
+
+	if got := n.Datacenter(); got != 7 {
+		t.Errorf("Datacenter() = %d, want 7", got)
+	}
+	if got := n.Worker(); got != 13 {
+		t.Errorf("Worker() = %d, want 13", got)
+	}
+
+	s := n.Generate()
+	l := n.Layout()
+	if got := l.Datacenter(s); got != 7 {
+		t.Errorf("Layout.Datacenter() = %d, want 7", got)
+	}
+	if got := l.Worker(s); got != 13 {
+		t.Errorf("Layout.Worker() = %d, want 13", got)
+	}
+}
+
+// TestWorkerIDFromIP checks WorkerIDFromIP against an independent re-derivation from
+// net.InterfaceAddrs, so a regression in the private-address filtering (e.g. accepting a
+// public IPv4 again) would show up as a mismatch here rather than only at deploy time.
+func TestWorkerIDFromIP(t *testing.T) {
+	var want uint32
+	var wantErr error = ErrorNoPrivateIPv4
+
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		t.Fatalf("net.InterfaceAddrs() error = %v", err)
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() {
+			continue
+		}
+		ip4 := ipNet.IP.To4()
+		if ip4 == nil || !ip4.IsPrivate() {
+			continue
+		}
+		want = uint32(ip4[2])<<8 | uint32(ip4[3])
+		wantErr = nil
+		break
+	}
+
+	got, err := WorkerIDFromIP()
+	if err != wantErr {
+		t.Fatalf("WorkerIDFromIP() error = %v, want %v", err, wantErr)
+	}
+	if err == nil && got != want {
+		t.Errorf("WorkerIDFromIP() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+package snowflake
+
+const (
+	base64Alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ+/"
+	base32Alphabet = "ybndrfg8ejkmcpqxot1uwisza345h769"
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// invalidDigit marks unused lookup table entries so a malformed character is caught in O(1),
+// rather than falling back to an if/else chain per character.
+const invalidDigit = 0xFF
+
+var (
+	base64Lookup [256]byte
+	base32Lookup [256]byte
+	base58Lookup [256]byte
+)
+
+func init() {
+	initLookup(&base64Lookup, base64Alphabet)
+	initLookup(&base32Lookup, base32Alphabet)
+	initLookup(&base58Lookup, base58Alphabet)
+}
+
+func initLookup(table *[256]byte, alphabet string) {
+	for i := range table {
+		table[i] = invalidDigit
+	}
+	for i := 0; i < len(alphabet); i++ {
+		table[alphabet[i]] = byte(i)
+	}
+}
+
+// Base64 converts the snowflake into base 64 using this package's alphabet, which includes '+'
+// and '/' and so is not URL-safe. The maximum resulting string length is 11 chars.
+func (s Snowflake) Base64() string {
+	return encodeBase(int64(s), base64Alphabet)
+}
+
+// ParseBase64 parses a string produced by Base64 back into a Snowflake.
+func ParseBase64(s string) (Snowflake, error) {
+	return parseBase(s, base64Lookup, int64(len(base64Alphabet)))
+}
+
+// Base32 converts the snowflake into base 32 using a Crockford-style, unambiguous alphabet
+// suitable for logs and URL paths.
+func (s Snowflake) Base32() string {
+	return encodeBase(int64(s), base32Alphabet)
+}
+
+// ParseBase32 parses a string produced by Base32 back into a Snowflake.
+func ParseBase32(s string) (Snowflake, error) {
+	return parseBase(s, base32Lookup, int64(len(base32Alphabet)))
+}
+
+// Base58 converts the snowflake into base 58 using the Bitcoin alphabet, which drops visually
+// similar characters (0, O, I, l).
+func (s Snowflake) Base58() string {
+	return encodeBase(int64(s), base58Alphabet)
+}
+
+// ParseBase58 parses a string produced by Base58 back into a Snowflake.
+func ParseBase58(s string) (Snowflake, error) {
+	return parseBase(s, base58Lookup, int64(len(base58Alphabet)))
+}
+
+func encodeBase(v int64, alphabet string) string {
+	if v == 0 {
+		return alphabet[0:1]
+	}
+	base := int64(len(alphabet))
+	// 16 digits comfortably covers a 63-bit value in the smallest supported base (32).
+	var buf [16]byte
+	i := len(buf)
+	for v != 0 {
+		i--
+		buf[i] = alphabet[v%base]
+		v /= base
+	}
+	return string(buf[i:])
+}
+
+func parseBase(s string, lookup [256]byte, base int64) (Snowflake, error) {
+	var val int64
+	for i := 0; i < len(s); i++ {
+		digit := lookup[s[i]]
+		if digit == invalidDigit {
+			return 0, ErrorInvalidChar
+		}
+		val = val*base + int64(digit)
+	}
+	return Snowflake(val), nil
+}
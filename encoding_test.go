@@ -0,0 +1,51 @@
+package snowflake
+
+import "testing"
+
+func TestEncodingRoundTrip(t *testing.T) {
+	values := []Snowflake{0, 1, 42, 1<<62 - 1}
+
+	cases := []struct {
+		name   string
+		encode func(Snowflake) string
+		parse  func(string) (Snowflake, error)
+	}{
+		{"Base64", Snowflake.Base64, ParseBase64},
+		{"Base32", Snowflake.Base32, ParseBase32},
+		{"Base58", Snowflake.Base58, ParseBase58},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for _, v := range values {
+				encoded := c.encode(v)
+				got, err := c.parse(encoded)
+				if err != nil {
+					t.Fatalf("parse(%q) error = %v", encoded, err)
+				}
+				if got != v {
+					t.Errorf("parse(encode(%d)) = %d, want %d", v, got, v)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodingInvalidChar(t *testing.T) {
+	cases := []struct {
+		name  string
+		parse func(string) (Snowflake, error)
+	}{
+		{"Base64", ParseBase64},
+		{"Base32", ParseBase32},
+		{"Base58", ParseBase58},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := c.parse(" "); err != ErrorInvalidChar {
+				t.Errorf("parse(%q) error = %v, want ErrorInvalidChar", " ", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package snowflake
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateWithMetaRoundTrip(t *testing.T) {
+	layout := Layout{Epoch: time.Now(), TimeBits: 37, NodeBits: 10, CountBits: 8, MetaBits: 8}
+	n, err := NewNode(3, layout)
+	if err != nil {
+		t.Fatalf("NewNode() error = %v", err)
+	}
+
+	s, err := n.GenerateWithMeta(42)
+	if err != nil {
+		t.Fatalf("GenerateWithMeta() error = %v", err)
+	}
+	if got := n.Layout().Meta(s); got != 42 {
+		t.Errorf("Meta() = %d, want 42", got)
+	}
+	if got := n.Layout().Node(s); got != 3 {
+		t.Errorf("Node() = %d, want 3", got)
+	}
+
+	if _, err := n.GenerateWithMeta(1 << 8); err != ErrorMetaOverflow {
+		t.Errorf("GenerateWithMeta() error = %v, want ErrorMetaOverflow", err)
+	}
+}
+
+func TestGenerateWithMetaDisabled(t *testing.T) {
+	n, err := NewNode(1, Layout{Epoch: time.Now(), TimeBits: 41, NodeBits: 10, CountBits: 12})
+	if err != nil {
+		t.Fatalf("NewNode() error = %v", err)
+	}
+	if _, err := n.GenerateWithMeta(1); err != ErrorMetaDisabled {
+		t.Errorf("GenerateWithMeta() error = %v, want ErrorMetaDisabled", err)
+	}
+}
+
+// TestGenerateWithMetaCombinedWithOtherFeatures exercises meta packing alongside the
+// datacenter/worker split and tick-tock handling added by earlier requests, to make sure the
+// meta slice doesn't collide with either.
+func TestGenerateWithMetaCombinedWithOtherFeatures(t *testing.T) {
+	layout := Layout{
+		Epoch:          time.Now(),
+		TimeBits:       33,
+		DatacenterBits: 5,
+		WorkerBits:     5,
+		CountBits:      8,
+		MetaBits:       8,
+	}
+	n, err := NewNodeWithDC(7, 9, layout, WithTickTock(nil))
+	if err != nil {
+		t.Fatalf("NewNodeWithDC() error = %v", err)
+	}
+
+	s, err := n.GenerateWithMeta(200)
+	if err != nil {
+		t.Fatalf("GenerateWithMeta() error = %v", err)
+	}
+
+	l := n.Layout()
+	if got := l.Meta(s); got != 200 {
+		t.Errorf("Meta() = %d, want 200", got)
+	}
+	if got := l.Datacenter(s); got != 7 {
+		t.Errorf("Datacenter() = %d, want 7", got)
+	}
+	if got := l.Worker(s); got != 9 {
+		t.Errorf("Worker() = %d, want 9", got)
+	}
+}
@@ -7,85 +7,264 @@ import (
 )
 
 var (
-	ErrorTimeOverflow      = errors.New("time overflowed its bit allowance")
-	ErrorNodeOverflow      = errors.New("node ID overflowed its bit allowance")
-	ErrorSnowflakeOverflow = errors.New("total bits allocated is greater than 63")
+	ErrorTimeOverflow         = errors.New("time overflowed its bit allowance")
+	ErrorNodeOverflow         = errors.New("node ID overflowed its bit allowance")
+	ErrorSnowflakeOverflow    = errors.New("total bits allocated is greater than 63")
+	ErrorInsufficientLifetime = errors.New("timeBits and TimeUnit cannot cover the requested MinLifetime")
 )
 
+// Layout describes how a Snowflake's bits are divided between the time, node, and counter
+// fields, and the epoch they're measured from. NewNode takes a Layout and keeps it on the
+// returned Node so IDs it generates can be decoded later; the same value can also be handed
+// to the package-level Decompose function, or its Time/Node/Counter methods, to decode IDs
+// produced elsewhere.
+//
+// DatacenterBits and WorkerBits are optional: set them to split NodeBits into a datacenter
+// component and a worker component (see NewNodeWithDC). Leave them zero to treat the node
+// field as a single opaque ID, as NewNode does.
+//
+// TickTock is set by WithTickTock; it reserves the top bit of the counter field as a tick-tock
+// toggle and should not be set directly by callers.
+//
+// TimeUnit is the duration one tick of the time field represents; it defaults to 1ms, matching
+// a classic Twitter-style snowflake. A coarser unit (e.g. 10ms) trades per-unit sequence range
+// for a longer lifetime before TimeBits overflows. MinLifetime, if set, makes NewNode reject a
+// TimeBits/TimeUnit combination that can't cover at least that long a lifetime.
+//
+// MetaBits is optional; set it to reserve an application-defined slice (see GenerateWithMeta)
+// between the time and node fields. Leave it zero to use the full bit budget for time/node/
+// counter.
+type Layout struct {
+	Epoch     time.Time
+	TimeBits  uint8
+	NodeBits  uint8
+	CountBits uint8
+
+	DatacenterBits uint8
+	WorkerBits     uint8
+
+	TickTock bool
+
+	TimeUnit    time.Duration
+	MinLifetime time.Duration
+
+	MetaBits uint8
+}
+
+// timeUnit returns the configured TimeUnit, defaulting to 1ms.
+func (l Layout) timeUnit() time.Duration {
+	if l.TimeUnit == 0 {
+		return time.Millisecond
+	}
+	return l.TimeUnit
+}
+
+// Time extracts the timestamp encoded in s under this Layout.
+func (l Layout) Time(s Snowflake) time.Time {
+	t, _, _ := Decompose(s, l)
+	return t
+}
+
+// Node extracts the node ID encoded in s under this Layout.
+func (l Layout) Node(s Snowflake) int64 {
+	_, node, _ := Decompose(s, l)
+	return node
+}
+
+// Counter extracts the per-TimeUnit-tick counter encoded in s under this Layout.
+func (l Layout) Counter(s Snowflake) int64 {
+	_, _, counter := Decompose(s, l)
+	return counter
+}
+
+// Decompose splits s back into the timestamp, node ID, and counter it was generated from,
+// given the Layout it was generated under. The Layout must match the one passed to NewNode,
+// since bit widths are configurable per Node.
+//
+// Wire-format note: prior to Decompose existing, Generate placed the node field at
+// bit offset 63-countBits, which overlapped the time field instead of sitting below it.
+// Decompose requires a non-overlapping layout to work at all, so Generate now places the
+// node field at bit offset countBits. Snowflakes generated and persisted under the old
+// packing will decode to different (and, under the old packing, partly garbled) time/node
+// values under this version.
+func Decompose(s Snowflake, layout Layout) (time.Time, int64, int64) {
+	v := int64(s)
+	ms := v >> (63 - layout.TimeBits)
+	node := (v >> layout.CountBits) & maxValueBits(layout.NodeBits)
+	countMask := maxValueBits(layout.CountBits)
+	if layout.TickTock {
+		countMask = maxValueBits(layout.CountBits - 1)
+	}
+	counter := v & countMask
+	t := layout.Epoch.Add(time.Duration(ms) * layout.timeUnit())
+	return t, node, counter
+}
+
 type Node struct {
 	mutex        sync.Mutex
 	lastGenerate int64 // how many ms since epoch the last ID was generated
 
-	epoch   time.Time
+	layout  Layout
 	id      int64
 	counter int64
+	tock    int64 // current tick-tock toggle, when layout.TickTock is set
 
-	timeBits  uint8
-	nodeBits  uint8
-	countBits uint8
+	inRegression bool // true while nowMs has stayed behind lastGenerate across calls
+
+	onClockDrift ClockDriftFunc
 
 	maxTime    int64
 	maxNode    int64
 	maxCounter int64
 }
 
-func NewNode(nodeID uint32, epoch time.Time, timeBits, nodeBits, counterBits uint8) (*Node, error) {
-	if timeBits+nodeBits+counterBits > 63 {
+func NewNode(nodeID uint32, layout Layout, opts ...NodeOption) (*Node, error) {
+	return newNode(int64(nodeID), layout, opts...)
+}
+
+func newNode(id int64, layout Layout, opts ...NodeOption) (*Node, error) {
+	if layout.TimeBits+layout.NodeBits+layout.CountBits+layout.MetaBits > 63 {
 		return nil, ErrorSnowflakeOverflow
 	}
+	layout.TimeUnit = layout.timeUnit()
 	n := &Node{}
 	n.mutex.Lock() // Do not allow ID generation during setup
 	now := time.Now()
-	n.epoch = now.Add(epoch.Sub(now)) // force monotonic clock usage to avoid
-	n.id = int64(nodeID)
+	layout.Epoch = now.Add(layout.Epoch.Sub(now)) // force monotonic clock usage to avoid
+	n.layout = layout
+	n.id = id
+	for _, opt := range opts {
+		opt(n)
+	}
 
-	n.timeBits = timeBits
-	n.nodeBits = nodeBits
-	n.countBits = counterBits
+	n.maxTime = maxValueBits(n.layout.TimeBits)
+	n.maxNode = maxValueBits(n.layout.NodeBits)
+	n.maxCounter = maxValueBits(n.layout.CountBits)
+	if n.layout.TickTock {
+		n.maxCounter = maxValueBits(n.layout.CountBits - 1)
+	}
 
-	n.maxTime = maxValueBits(timeBits)
-	n.maxNode = maxValueBits(nodeBits)
-	n.maxCounter = maxValueBits(counterBits)
+	if n.layout.MinLifetime > 0 {
+		lifetime := time.Duration(n.maxTime) * n.layout.TimeUnit
+		if lifetime < n.layout.MinLifetime {
+			return nil, ErrorInsufficientLifetime
+		}
+	}
 
-	n.lastGenerate = n.msSinceEpoch()
+	n.lastGenerate = n.elapsedUnits()
 
-	if int64(nodeID) > n.maxNode {
+	if id > n.maxNode {
 		return nil, ErrorNodeOverflow
 	}
 	n.mutex.Unlock()
 	return n, nil
 }
 
+// Layout returns the Layout this Node was constructed with, for passing to Decompose.
+func (n *Node) Layout() Layout {
+	return n.layout
+}
+
 // Generate creates a snowflake based off the state of the Node. Panics if the time is greater than the
 // allocated bit count to prevent incorrect ID generation. This should only happen if the node was created with
-// insufficient space for the time. Generate will wait for the next millisecond, should it generate 2^countBits
-// Snowflakes in the same millisecond.
-// For example, if timeBits is 32, any ids created after epoch + 1.08 years would panic.
+// insufficient space for the time. Generate will wait for the next TimeUnit tick, should it generate 2^countBits
+// Snowflakes within the same tick (2^(countBits-1) with WithTickTock, which reserves one counter bit).
+// For example, at the default 1ms TimeUnit, if timeBits is 32, any ids created after epoch + ~49.7 days would panic.
+//
+// If the Node was built with WithTickTock, a clock regression does not block or panic: the
+// tick-tock bit flips and IDs keep being generated against the last-seen timestamp until the
+// wall clock catches back up.
 func (n *Node) Generate() Snowflake {
 	n.mutex.Lock()
 	defer n.mutex.Unlock()
-	nowMs := n.msSinceEpoch()
-	if nowMs == n.lastGenerate {
+	ms, counter := n.nextTick()
+	return Snowflake(n.pack(ms, counter))
+}
+
+// nextTick advances the Node's clock/counter state and returns the timestamp and counter to
+// encode, handling same-tick rollover and (when enabled) tick-tock clock regressions. Callers
+// must hold n.mutex.
+func (n *Node) nextTick() (ms, counter int64) {
+	nowMs := n.elapsedUnits()
+	effectiveMs := nowMs
+	switch {
+	case n.layout.TickTock && nowMs < n.lastGenerate:
+		if !n.inRegression {
+			// the first tick observed behind lastGenerate: flip the toggle once and start a
+			// fresh counter run under the pinned timestamp.
+			n.tock ^= 1
+			n.counter = 0
+			n.inRegression = true
+			n.reportClockDrift(nowMs)
+			effectiveMs = n.lastGenerate
+		} else if n.counter++; n.counter > n.maxCounter {
+			// still behind from an earlier call, and the counter budget under the pinned
+			// timestamp is exhausted: wait for the clock to catch back up, then resume
+			// normal generation under the new tick. Reusing the pinned timestamp with a
+			// reset counter here would replay an already-issued (timestamp, counter) pair.
+			nowMs = n.waitPastTick(nowMs, n.lastGenerate, true)
+			n.inRegression = false
+			n.counter = 0
+			effectiveMs = nowMs
+		} else {
+			// still behind from an earlier call, counter has headroom: keep incrementing it
+			// under the same pinned timestamp instead of flipping the toggle again (which
+			// would make IDs repeat with period 2).
+			effectiveMs = n.lastGenerate
+		}
+	case nowMs == n.lastGenerate:
+		n.inRegression = false
 		n.counter++
 		if n.counter > n.maxCounter {
-			for nowMs <= n.lastGenerate { // wait until the next millisecond
-				nowMs = n.msSinceEpoch()
-				n.counter = 0
-			}
+			effectiveMs = n.waitPastTick(nowMs, n.lastGenerate, true)
 		}
-	} else {
+	default:
+		n.inRegression = false
 		n.counter = 0
+		effectiveMs = nowMs
 	}
 	// check if the time would overflow the bits allocated to it.
-	if nowMs > n.maxTime {
+	if effectiveMs > n.maxTime {
 		panic(ErrorTimeOverflow)
 	}
-	n.lastGenerate = nowMs
-	return Snowflake(nowMs<<(63-n.timeBits) | n.id<<(63-n.countBits) | n.counter)
+	n.lastGenerate = effectiveMs
+	return effectiveMs, n.counter
+}
+
+// waitPastTick busy-waits, resetting the counter on every failed attempt, until elapsedUnits()
+// moves past boundary (strictly past when inclusive, or merely not before it otherwise), and
+// returns the new reading.
+func (n *Node) waitPastTick(nowMs, boundary int64, inclusive bool) int64 {
+	for (inclusive && nowMs <= boundary) || (!inclusive && nowMs < boundary) {
+		nowMs = n.elapsedUnits()
+		n.counter = 0
+	}
+	return nowMs
+}
+
+// pack assembles the final Snowflake bits from a timestamp and counter, folding in the node ID
+// and, when enabled, the tick-tock toggle.
+func (n *Node) pack(ms, counter int64) int64 {
+	if n.layout.TickTock {
+		counter |= n.tock << (n.layout.CountBits - 1)
+	}
+	return ms<<(63-n.layout.TimeBits) | n.id<<n.layout.CountBits | counter
+}
+
+func (n *Node) reportClockDrift(observedMs int64) {
+	if n.onClockDrift == nil {
+		return
+	}
+	epoch := n.layout.Epoch
+	unit := n.layout.TimeUnit
+	previous := epoch.Add(time.Duration(n.lastGenerate) * unit)
+	observed := epoch.Add(time.Duration(observedMs) * unit)
+	n.onClockDrift(previous, observed)
 }
 
-func (n *Node) msSinceEpoch() int64 {
-	return time.Since(n.epoch).Nanoseconds() / 1e6
+// elapsedUnits returns how many TimeUnit ticks have passed since the Node's epoch.
+func (n *Node) elapsedUnits() int64 {
+	return int64(time.Since(n.layout.Epoch) / n.layout.TimeUnit)
 }
 
 func maxValueBits(n uint8) int64 {
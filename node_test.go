@@ -0,0 +1,64 @@
+package snowflake
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecomposeRoundTrip(t *testing.T) {
+	layout := Layout{
+		Epoch:     time.Now(),
+		TimeBits:  41,
+		NodeBits:  10,
+		CountBits: 12,
+	}
+
+	cases := []struct {
+		name   string
+		nodeID uint32
+	}{
+		{"zero node", 0},
+		{"mid-range node", 512},
+		{"max node", uint32(maxValueBits(layout.NodeBits))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n, err := NewNode(c.nodeID, layout)
+			if err != nil {
+				t.Fatalf("NewNode() error = %v", err)
+			}
+
+			s := n.Generate()
+			gotTime, gotNode, gotCounter := Decompose(s, n.Layout())
+
+			if gotNode != int64(c.nodeID) {
+				t.Errorf("Decompose() node = %d, want %d", gotNode, c.nodeID)
+			}
+			if d := gotTime.Sub(time.Now()); d > time.Second || d < -time.Second {
+				t.Errorf("Decompose() time = %v, too far from now", gotTime)
+			}
+
+			// A second Generate() in the same tick should bump only the counter.
+			s2 := n.Generate()
+			_, node2, counter2 := Decompose(s2, n.Layout())
+			if node2 != gotNode {
+				t.Errorf("Decompose() node changed across Generate() calls: %d != %d", node2, gotNode)
+			}
+			if counter2 != gotCounter+1 {
+				t.Errorf("Decompose() counter = %d, want %d", counter2, gotCounter+1)
+			}
+		})
+	}
+}
+
+func TestNewNodeOverflow(t *testing.T) {
+	layout := Layout{TimeBits: 41, NodeBits: 11, CountBits: 12} // 64 > 63
+	if _, err := NewNode(0, layout); err != ErrorSnowflakeOverflow {
+		t.Fatalf("NewNode() error = %v, want ErrorSnowflakeOverflow", err)
+	}
+
+	if _, err := NewNode(1<<10, Layout{TimeBits: 41, NodeBits: 10, CountBits: 12}); err != ErrorNodeOverflow {
+		t.Fatalf("NewNode() error = %v, want ErrorNodeOverflow", err)
+	}
+}
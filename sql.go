@@ -0,0 +1,83 @@
+package snowflake
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+)
+
+// Bytes returns s as an 8-byte big-endian array, for keying into maps, BoltDB-style byte-ordered
+// stores, or anywhere else a fixed-width binary form is more convenient than an int64.
+func (s Snowflake) Bytes() [8]byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(s))
+	return b
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, producing the same 8-byte big-endian
+// representation as Bytes.
+func (s Snowflake) MarshalBinary() ([]byte, error) {
+	b := s.Bytes()
+	return b[:], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *Snowflake) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("snowflake: invalid binary length %d, want 8", len(data))
+	}
+	*s = Snowflake(binary.BigEndian.Uint64(data))
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, producing the same decimal form as String.
+func (s Snowflake) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Snowflake) UnmarshalText(text []byte) error {
+	i, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+	*s = Snowflake(i)
+	return nil
+}
+
+// Value implements driver.Valuer so a Snowflake can be passed directly to a database/sql
+// driver, without an explicit Int64() conversion.
+func (s Snowflake) Value() (driver.Value, error) {
+	return int64(s), nil
+}
+
+// Scan implements sql.Scanner, accepting the int64, []byte, and string forms a driver may hand
+// back depending on the column type (e.g. int8 vs a text column). A []byte is parsed as decimal
+// text, like a string; the 8-byte form from MarshalBinary is not accepted here.
+func (s *Snowflake) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*s = 0
+		return nil
+	case int64:
+		*s = Snowflake(v)
+		return nil
+	case []byte:
+		i, err := strconv.ParseInt(string(v), 10, 64)
+		if err != nil {
+			return err
+		}
+		*s = Snowflake(i)
+		return nil
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*s = Snowflake(i)
+		return nil
+	default:
+		return fmt.Errorf("snowflake: unsupported Scan source type %T", src)
+	}
+}
@@ -0,0 +1,96 @@
+package snowflake
+
+import "testing"
+
+func TestValue(t *testing.T) {
+	s := Snowflake(12345)
+	v, err := s.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if v != int64(12345) {
+		t.Errorf("Value() = %v, want 12345", v)
+	}
+}
+
+func TestScan(t *testing.T) {
+	cases := []struct {
+		name string
+		src  interface{}
+		want Snowflake
+	}{
+		{"int64", int64(99), 99},
+		{"[]byte", []byte("99"), 99},
+		{"string", "99", 99},
+		{"nil", nil, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var s Snowflake = 1 // seed with a non-zero value so nil's reset is observable
+			if err := s.Scan(c.src); err != nil {
+				t.Fatalf("Scan(%v) error = %v", c.src, err)
+			}
+			if s != c.want {
+				t.Errorf("Scan(%v) = %d, want %d", c.src, s, c.want)
+			}
+		})
+	}
+
+	var s Snowflake
+	if err := s.Scan(3.14); err == nil {
+		t.Error("Scan(float64) error = nil, want an error for an unsupported type")
+	}
+}
+
+func TestBytesRoundTrip(t *testing.T) {
+	s := Snowflake(1 << 40)
+	b := s.Bytes()
+
+	var got Snowflake
+	if err := got.UnmarshalBinary(b[:]); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	if got != s {
+		t.Errorf("UnmarshalBinary(Bytes()) = %d, want %d", got, s)
+	}
+}
+
+func TestMarshalBinaryRoundTrip(t *testing.T) {
+	s := Snowflake(987654321)
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+
+	var got Snowflake
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+	if got != s {
+		t.Errorf("UnmarshalBinary(MarshalBinary()) = %d, want %d", got, s)
+	}
+
+	if err := got.UnmarshalBinary(data[:4]); err == nil {
+		t.Error("UnmarshalBinary() error = nil, want an error for a short buffer")
+	}
+}
+
+func TestMarshalTextRoundTrip(t *testing.T) {
+	s := Snowflake(42)
+	text, err := s.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if string(text) != "42" {
+		t.Errorf("MarshalText() = %q, want %q", text, "42")
+	}
+
+	var got Snowflake
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if got != s {
+		t.Errorf("UnmarshalText(MarshalText()) = %d, want %d", got, s)
+	}
+}
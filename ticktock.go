@@ -0,0 +1,22 @@
+package snowflake
+
+import "time"
+
+// NodeOption configures optional Node behavior at construction time, via NewNode or
+// NewNodeWithDC.
+type NodeOption func(*Node)
+
+// ClockDriftFunc is invoked when a Node configured with WithTickTock observes the wall clock
+// moving backward relative to the last generated Snowflake.
+type ClockDriftFunc func(previous, observed time.Time)
+
+// WithTickTock enables tick-tock handling: one bit of the counter field becomes a toggle that
+// flips whenever Generate observes the wall clock moving backward, trading counter headroom for
+// monotonicity across clock regressions instead of panicking or emitting duplicate-order IDs.
+// drift, if non-nil, is called on every regression with the previous and observed timestamps.
+func WithTickTock(drift ClockDriftFunc) NodeOption {
+	return func(n *Node) {
+		n.layout.TickTock = true
+		n.onClockDrift = drift
+	}
+}
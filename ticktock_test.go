@@ -0,0 +1,75 @@
+package snowflake
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTickTockRegressionNoDuplicates simulates a clock regression that lasts across several
+// Generate() calls (an NTP step or VM pause), which should flip the tick-tock toggle exactly
+// once and keep emitting unique, monotonic IDs for as long as the clock stays behind.
+func TestTickTockRegressionNoDuplicates(t *testing.T) {
+	var drifts int
+	n, err := NewNode(1, Layout{Epoch: time.Now(), TimeBits: 41, NodeBits: 10, CountBits: 12},
+		WithTickTock(func(previous, observed time.Time) { drifts++ }))
+	if err != nil {
+		t.Fatalf("NewNode() error = %v", err)
+	}
+
+	n.mutex.Lock()
+	n.lastGenerate = n.elapsedUnits() + 1000 // pretend the clock later moved far backward
+	n.mutex.Unlock()
+
+	seen := make(map[Snowflake]bool)
+	for i := 0; i < 10; i++ {
+		s := n.Generate()
+		if seen[s] {
+			t.Fatalf("duplicate Snowflake generated on call %d: %d", i, s)
+		}
+		seen[s] = true
+	}
+
+	if drifts != 1 {
+		t.Fatalf("ClockDriftFunc called %d times, want exactly 1 for a single regression", drifts)
+	}
+}
+
+// TestTickTockRegressionCounterOverflow drives the counter past maxCounter while the clock is
+// still behind lastGenerate, which forces nextTick to wait for the clock to catch up mid-
+// regression rather than replaying the pinned timestamp with a reset counter.
+func TestTickTockRegressionCounterOverflow(t *testing.T) {
+	layout := Layout{Epoch: time.Now(), TimeBits: 41, NodeBits: 10, CountBits: 2, TimeUnit: time.Millisecond}
+	n, err := NewNode(1, layout, WithTickTock(nil))
+	if err != nil {
+		t.Fatalf("NewNode() error = %v", err)
+	}
+
+	n.mutex.Lock()
+	n.lastGenerate = n.elapsedUnits() + 2 // a couple ms ahead, at millisecond resolution
+	n.mutex.Unlock()
+
+	seen := make(map[Snowflake]bool)
+	start := time.Now()
+	for i := 0; i < 8; i++ {
+		s := n.Generate()
+		if seen[s] {
+			t.Fatalf("duplicate Snowflake generated on call %d: %d", i, s)
+		}
+		seen[s] = true
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("overflow wait took too long: %v", elapsed)
+	}
+}
+
+func TestTickTockReservesCounterBit(t *testing.T) {
+	layout := Layout{Epoch: time.Now(), TimeBits: 41, NodeBits: 10, CountBits: 12}
+	n, err := NewNode(1, layout, WithTickTock(nil))
+	if err != nil {
+		t.Fatalf("NewNode() error = %v", err)
+	}
+	want := maxValueBits(layout.CountBits - 1)
+	if n.maxCounter != want {
+		t.Fatalf("maxCounter = %d, want %d (one bit reserved for tick-tock)", n.maxCounter, want)
+	}
+}
@@ -0,0 +1,58 @@
+package snowflake
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeUnitDefault(t *testing.T) {
+	layout := Layout{Epoch: time.Now(), TimeBits: 41, NodeBits: 10, CountBits: 12}
+	n, err := NewNode(1, layout)
+	if err != nil {
+		t.Fatalf("NewNode() error = %v", err)
+	}
+	if got := n.Layout().TimeUnit; got != time.Millisecond {
+		t.Errorf("TimeUnit = %v, want %v (the default)", got, time.Millisecond)
+	}
+}
+
+func TestTimeUnitCustomResolution(t *testing.T) {
+	layout := Layout{Epoch: time.Now(), TimeBits: 39, NodeBits: 10, CountBits: 12, TimeUnit: 10 * time.Millisecond}
+	n, err := NewNode(1, layout)
+	if err != nil {
+		t.Fatalf("NewNode() error = %v", err)
+	}
+
+	s := n.Generate()
+	got := n.Layout().Time(s)
+	if d := got.Sub(time.Now()); d > 50*time.Millisecond || d < -50*time.Millisecond {
+		t.Errorf("Time() = %v, too far from now for a 10ms unit", got)
+	}
+}
+
+func TestMinLifetimeInsufficient(t *testing.T) {
+	layout := Layout{
+		Epoch:       time.Now(),
+		TimeBits:    20, // ~17.5 minutes of range at the default 1ms unit
+		NodeBits:    10,
+		CountBits:   12,
+		MinLifetime: 24 * time.Hour,
+	}
+	if _, err := NewNode(1, layout); err != ErrorInsufficientLifetime {
+		t.Errorf("NewNode() error = %v, want ErrorInsufficientLifetime", err)
+	}
+}
+
+func TestMinLifetimeSatisfied(t *testing.T) {
+	layout := Layout{
+		Epoch:       time.Now(),
+		TimeBits:    39,
+		NodeBits:    10,
+		CountBits:   12,
+		TimeUnit:    10 * time.Millisecond,
+		MinLifetime: 100 * 365 * 24 * time.Hour, // ~100 years, well under the ~174 years 39 bits/10ms covers
+	}
+	if _, err := NewNode(1, layout); err != nil {
+		t.Errorf("NewNode() error = %v, want nil", err)
+	}
+}